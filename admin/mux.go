@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+)
+
+// MuxOptions configures the handlers mounted by NewMux beyond the built-in
+// health/readiness/pprof/vars endpoints.
+type MuxOptions struct {
+	BasicAuthUser  string
+	BasicAuthPass  string
+	MetricsHandler http.Handler
+}
+
+// NewMux builds the admin handler: /healthz, /readyz, /metrics (if
+// MetricsHandler is set), /debug/pprof/* and /debug/vars, optionally
+// protected by HTTP basic auth.
+func NewMux(registry *Registry, opts MuxOptions) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/healthz", healthzHandler(registry))
+	mux.HandleFunc("/readyz", readyzHandler(registry))
+
+	if opts.MetricsHandler != nil {
+		mux.Handle("/metrics", opts.MetricsHandler)
+	}
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	var h http.Handler = mux
+	if opts.BasicAuthUser != "" {
+		h = basicAuth(h, opts.BasicAuthUser, opts.BasicAuthPass)
+	}
+	return h
+}
+
+func healthzHandler(registry *Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Liveness: the process is up and serving, regardless of the
+		// outcome of any registered health checks.
+		results, _ := runChecks(r.Context(), registry.healthChecks())
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"status": "ok",
+			"checks": results,
+		})
+	}
+}
+
+func readyzHandler(registry *Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !registry.Ready() {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]interface{}{
+				"status": "not ready",
+			})
+			return
+		}
+
+		results, ok := runChecks(r.Context(), registry.readinessChecks())
+		status := http.StatusOK
+		body := "ready"
+		if !ok {
+			status = http.StatusServiceUnavailable
+			body = "not ready"
+		}
+		writeJSON(w, status, map[string]interface{}{
+			"status": body,
+			"checks": results,
+		})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func basicAuth(next http.Handler, user, pass string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u, p, ok := r.BasicAuth()
+		if !ok || subtle.ConstantTimeCompare([]byte(u), []byte(user)) != 1 || subtle.ConstantTimeCompare([]byte(p), []byte(pass)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="admin"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
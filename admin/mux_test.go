@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMuxHealthzAlwaysOK(t *testing.T) {
+	registry := NewRegistry()
+	mux := NewMux(registry, MuxOptions{})
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected /healthz to be 200 regardless of readiness, got %d", rr.Code)
+	}
+}
+
+func TestMuxReadyzReflectsReadiness(t *testing.T) {
+	registry := NewRegistry()
+	mux := NewMux(registry, MuxOptions{})
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected /readyz to be 503 before SetReady(true), got %d", rr.Code)
+	}
+
+	registry.SetReady(true)
+
+	rr = httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected /readyz to be 200 once ready, got %d", rr.Code)
+	}
+}
+
+func TestMuxReadyzFailsOnFailingCheck(t *testing.T) {
+	registry := NewRegistry()
+	registry.SetReady(true)
+	registry.AddReadinessCheck("db", func(ctx context.Context) error { return errors.New("down") })
+	mux := NewMux(registry, MuxOptions{})
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected /readyz to be 503 when a readiness check fails, got %d", rr.Code)
+	}
+}
+
+func TestMuxBasicAuth(t *testing.T) {
+	registry := NewRegistry()
+	mux := NewMux(registry, MuxOptions{BasicAuthUser: "admin", BasicAuthPass: "secret"})
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rr.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without credentials, got %d", rr.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	req.SetBasicAuth("admin", "secret")
+	rr = httptest.NewRecorder()
+	mux.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200 with valid credentials, got %d", rr.Code)
+	}
+}
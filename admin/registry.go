@@ -0,0 +1,97 @@
+// Package admin provides the operator-facing endpoints (health, readiness,
+// metrics, pprof) mounted on a service's admin listener.
+package admin
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Check is a single named health or readiness probe.
+type Check struct {
+	Name string
+	Fn   func(ctx context.Context) error
+}
+
+// CheckResult is the outcome of running a single Check.
+type CheckResult struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+	Latency string `json:"latency"`
+}
+
+// Registry holds the health/readiness checks registered for a service and
+// its current readiness state.
+type Registry struct {
+	mu        sync.RWMutex
+	ready     bool
+	health    []Check
+	readiness []Check
+}
+
+// NewRegistry creates an empty, not-ready Registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// AddHealthCheck registers a check whose result is reported by /healthz for
+// diagnostics, without affecting its status code.
+func (r *Registry) AddHealthCheck(name string, fn func(ctx context.Context) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.health = append(r.health, Check{Name: name, Fn: fn})
+}
+
+// AddReadinessCheck registers a check that gates /readyz: if it fails,
+// /readyz responds 503.
+func (r *Registry) AddReadinessCheck(name string, fn func(ctx context.Context) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.readiness = append(r.readiness, Check{Name: name, Fn: fn})
+}
+
+// SetReady flips the service's readiness state, as driven by the service
+// lifecycle (not-ready before start and during shutdown, ready once started).
+func (r *Registry) SetReady(ready bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ready = ready
+}
+
+// Ready reports the current readiness state.
+func (r *Registry) Ready() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.ready
+}
+
+func (r *Registry) healthChecks() []Check {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]Check(nil), r.health...)
+}
+
+func (r *Registry) readinessChecks() []Check {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]Check(nil), r.readiness...)
+}
+
+func runChecks(ctx context.Context, checks []Check) ([]CheckResult, bool) {
+	results := make([]CheckResult, 0, len(checks))
+	ok := true
+	for _, c := range checks {
+		start := time.Now()
+		err := c.Fn(ctx)
+		res := CheckResult{Name: c.Name, Status: "ok", Latency: time.Since(start).String()}
+		if err != nil {
+			res.Status = "error"
+			res.Error = err.Error()
+			ok = false
+		}
+		results = append(results, res)
+	}
+	return results, ok
+}
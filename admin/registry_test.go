@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRegistryReadyDefaultsToFalse(t *testing.T) {
+	r := NewRegistry()
+	if r.Ready() {
+		t.Fatal("expected a new Registry to start not-ready")
+	}
+
+	r.SetReady(true)
+	if !r.Ready() {
+		t.Fatal("expected Ready to reflect SetReady(true)")
+	}
+
+	r.SetReady(false)
+	if r.Ready() {
+		t.Fatal("expected Ready to reflect SetReady(false)")
+	}
+}
+
+func TestRegistryRunChecks(t *testing.T) {
+	r := NewRegistry()
+	r.AddHealthCheck("always-ok", func(ctx context.Context) error { return nil })
+	r.AddReadinessCheck("db", func(ctx context.Context) error { return errors.New("no connection") })
+
+	results, ok := runChecks(context.Background(), r.readinessChecks())
+	if ok {
+		t.Fatal("expected runChecks to report failure when a check errors")
+	}
+	if len(results) != 1 || results[0].Status != "error" || results[0].Error != "no connection" {
+		t.Fatalf("unexpected readiness result: %+v", results)
+	}
+
+	results, ok = runChecks(context.Background(), r.healthChecks())
+	if !ok || len(results) != 1 || results[0].Status != "ok" {
+		t.Fatalf("unexpected health result: %+v", results)
+	}
+}
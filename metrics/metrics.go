@@ -0,0 +1,125 @@
+// Package metrics provides RED (rate, errors, duration) HTTP instrumentation
+// for services built on this module, exported in Prometheus format.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics holds the Prometheus collectors used to instrument HTTP endpoints.
+type Metrics struct {
+	reg              prometheus.Registerer
+	requestsTotal    *prometheus.CounterVec
+	requestsInFlight *prometheus.GaugeVec
+	requestDuration  *prometheus.HistogramVec
+	responseSize     *prometheus.HistogramVec
+}
+
+// New creates the HTTP instrumentation collectors and registers them, along
+// with the standard process and Go runtime collectors, against reg.
+func New(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		reg: reg,
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total number of HTTP requests, labeled by method, route and status code.",
+		}, []string{"method", "route", "status"}),
+		requestsInFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "Number of HTTP requests currently being served, labeled by method and route.",
+		}, []string{"method", "route"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency in seconds, labeled by method, route and status code.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		responseSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_response_size_bytes",
+			Help:    "HTTP response size in bytes, labeled by method, route and status code.",
+			Buckets: prometheus.ExponentialBuckets(128, 4, 8),
+		}, []string{"method", "route", "status"}),
+	}
+
+	reg.MustRegister(
+		m.requestsTotal,
+		m.requestsInFlight,
+		m.requestDuration,
+		m.responseSize,
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewGoCollector(),
+	)
+
+	return m
+}
+
+// Wrap instruments next with request count, in-flight gauge and latency
+// histogram metrics. The route label is derived from chi's resolved route
+// pattern so path parameters don't explode label cardinality.
+func (m *Metrics) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := routePattern(r)
+		m.requestsInFlight.WithLabelValues(r.Method, route).Inc()
+
+		start := time.Now()
+		rw := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+
+		// Deferred so a panic unwinding past next.ServeHTTP (e.g. into an
+		// outer recovery middleware) still records the completed request
+		// instead of silently dropping it from the RED metrics.
+		defer func() {
+			m.requestsInFlight.WithLabelValues(r.Method, route).Dec()
+
+			status := strconv.Itoa(rw.status)
+			m.requestsTotal.WithLabelValues(r.Method, route, status).Inc()
+			m.requestDuration.WithLabelValues(r.Method, route, status).Observe(time.Since(start).Seconds())
+			m.responseSize.WithLabelValues(r.Method, route, status).Observe(float64(rw.bytes))
+		}()
+
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// Handler returns the HTTP handler that exposes the collected metrics in
+// the Prometheus exposition format. If the Registerer passed to New also
+// implements prometheus.Gatherer (as *prometheus.Registry does), it is used
+// to gather the reported metrics; otherwise the default registry is used.
+func (m *Metrics) Handler() http.Handler {
+	gatherer, ok := m.reg.(prometheus.Gatherer)
+	if !ok {
+		gatherer = prometheus.DefaultGatherer
+	}
+	return promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{})
+}
+
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		if p := rctx.RoutePattern(); p != "" {
+			return p
+		}
+	}
+	return r.URL.Path
+}
+
+type responseWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (rw *responseWriter) WriteHeader(code int) {
+	rw.status = code
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	n, err := rw.ResponseWriter.Write(b)
+	rw.bytes += n
+	return n, err
+}
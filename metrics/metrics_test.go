@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestMetricsWrapRecordsCompletedRequest(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := New(reg)
+
+	h := m.Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+		w.Write([]byte("short and stout"))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/brew", nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusTeapot {
+		t.Fatalf("unexpected status, got %d, expected %d", rr.Code, http.StatusTeapot)
+	}
+
+	count := testutilCounterValue(t, m.requestsTotal.WithLabelValues(http.MethodGet, "/brew", "418"))
+	if count != 1 {
+		t.Fatalf("expected requestsTotal to be 1, got %v", count)
+	}
+}
+
+func TestMetricsWrapRecordsOnPanic(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := New(reg)
+
+	h := m.Wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/boom", nil)
+	rr := httptest.NewRecorder()
+
+	defer func() {
+		if rec := recover(); rec == nil {
+			t.Fatal("expected the panic to propagate past Wrap")
+		}
+
+		count := testutilCounterValue(t, m.requestsTotal.WithLabelValues(http.MethodGet, "/boom", "200"))
+		if count != 1 {
+			t.Fatalf("expected requestsTotal to still be recorded on panic, got %v", count)
+		}
+	}()
+
+	h.ServeHTTP(rr, req)
+}
+
+func testutilCounterValue(t *testing.T, c prometheus.Counter) float64 {
+	t.Helper()
+	var m dto.Metric
+	if err := c.Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	return m.GetCounter().GetValue()
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// CORSOptions configures the origins, methods and headers the CORS
+// middleware allows.
+type CORSOptions struct {
+	Origins []string
+	Methods []string
+	Headers []string
+}
+
+// CORS applies Access-Control-* response headers based on opts and answers
+// preflight OPTIONS requests directly.
+func CORS(opts CORSOptions) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin != "" && originAllowed(opts.Origins, origin) {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				if len(opts.Methods) > 0 {
+					w.Header().Set("Access-Control-Allow-Methods", strings.Join(opts.Methods, ", "))
+				}
+				if len(opts.Headers) > 0 {
+					w.Header().Set("Access-Control-Allow-Headers", strings.Join(opts.Headers, ", "))
+				}
+			}
+
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func originAllowed(allowed []string, origin string) bool {
+	for _, a := range allowed {
+		if a == "*" || a == origin {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCORSAllowsConfiguredOrigin(t *testing.T) {
+	h := CORS(CORSOptions{
+		Origins: []string{"https://example.com"},
+		Methods: []string{"GET", "POST"},
+		Headers: []string{"Authorization"},
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Origin", "https://example.com")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	if got := rr.Header().Get("Access-Control-Allow-Origin"); got != "https://example.com" {
+		t.Fatalf("expected Access-Control-Allow-Origin to be echoed, got %q", got)
+	}
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected the request to reach the handler, got status %d", rr.Code)
+	}
+}
+
+func TestCORSRejectsUnlistedOrigin(t *testing.T) {
+	h := CORS(CORSOptions{Origins: []string{"https://example.com"}})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Origin", "https://evil.example")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	if got := rr.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Fatalf("expected no Access-Control-Allow-Origin for an unlisted origin, got %q", got)
+	}
+}
+
+func TestCORSAnswersPreflightDirectly(t *testing.T) {
+	called := false
+	h := CORS(CORSOptions{Origins: []string{"*"}})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req := httptest.NewRequest(http.MethodOptions, "/", nil)
+	req.Header.Set("Origin", "https://example.com")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("expected a 204 for a preflight OPTIONS request, got %d", rr.Code)
+	}
+	if called {
+		t.Fatal("expected the preflight request to be answered without reaching the handler")
+	}
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strings"
+)
+
+// Gzip compresses responses for clients that accept gzip encoding. If
+// allowedContentTypes is non-empty, only responses whose Content-Type has
+// one of those prefixes are compressed; otherwise all responses are.
+func Gzip(allowedContentTypes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			gw := &gzipResponseWriter{ResponseWriter: w, allowed: allowedContentTypes}
+			defer gw.Close()
+
+			next.ServeHTTP(gw, r)
+		})
+	}
+}
+
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	writer   *gzip.Writer
+	allowed  []string
+	decided  bool
+	compress bool
+}
+
+func (g *gzipResponseWriter) WriteHeader(code int) {
+	g.decide()
+	g.ResponseWriter.WriteHeader(code)
+}
+
+func (g *gzipResponseWriter) Write(b []byte) (int, error) {
+	g.decide()
+	if g.compress {
+		return g.writer.Write(b)
+	}
+	return g.ResponseWriter.Write(b)
+}
+
+func (g *gzipResponseWriter) decide() {
+	if g.decided {
+		return
+	}
+	g.decided = true
+
+	if len(g.allowed) == 0 {
+		g.compress = true
+	} else {
+		ct := g.Header().Get("Content-Type")
+		for _, a := range g.allowed {
+			if strings.HasPrefix(ct, a) {
+				g.compress = true
+				break
+			}
+		}
+	}
+
+	if g.compress {
+		g.Header().Set("Content-Encoding", "gzip")
+		g.Header().Del("Content-Length")
+		g.writer = gzip.NewWriter(g.ResponseWriter)
+	}
+}
+
+// Close flushes and closes the underlying gzip writer, if the response
+// ended up being compressed.
+func (g *gzipResponseWriter) Close() error {
+	if g.writer != nil {
+		return g.writer.Close()
+	}
+	return nil
+}
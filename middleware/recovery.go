@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Recovery recovers from panics anywhere in the handler chain, logs the
+// stack trace, marks the active span (if any) as errored, and responds 500.
+func Recovery() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				if rec := recover(); rec != nil {
+					log.Error().
+						Interface("panic", rec).
+						Str("stack", string(debug.Stack())).
+						Msg("recovered from panic")
+
+					span := trace.SpanFromContext(r.Context())
+					span.RecordError(fmt.Errorf("panic: %v", rec))
+					span.SetStatus(codes.Error, "panic recovered")
+
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRecoveryRecoversAndMarksSpanErrored(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := trace.NewTracerProvider(trace.WithSpanProcessor(sr))
+
+	h := Recovery()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	ctx, span := tp.Tracer("test").Start(httptest.NewRequest(http.MethodGet, "/", nil).Context(), "op")
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(rr, req)
+	span.End()
+
+	if rr.Code != http.StatusInternalServerError {
+		t.Fatalf("expected 500 after a recovered panic, got %d", rr.Code)
+	}
+
+	ended := sr.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("expected exactly one ended span, got %d", len(ended))
+	}
+	if ended[0].Status().Code != codes.Error {
+		t.Fatalf("expected the span status to be Error, got %v", ended[0].Status().Code)
+	}
+	if len(ended[0].Events()) == 0 {
+		t.Fatal("expected RecordError to have added a span event")
+	}
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+type contextKey int
+
+const requestIDKey contextKey = iota
+
+// RequestIDHeader is the header used to propagate and echo back the
+// correlation id for a request.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID propagates an inbound X-Request-ID header, or generates a new
+// one, storing it on the request context and echoing it back on the
+// response so callers can correlate logs and traces across services.
+func RequestID() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(RequestIDHeader)
+			if id == "" {
+				id = newRequestID()
+			}
+			w.Header().Set(RequestIDHeader, id)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey, id)))
+		})
+	}
+}
+
+// RequestIDFromContext returns the correlation id stored by RequestID, or
+// an empty string if none is present.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
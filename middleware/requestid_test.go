@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestIDGeneratesWhenAbsent(t *testing.T) {
+	var seen string
+	h := RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	if seen == "" {
+		t.Fatal("expected a request id to be generated and stored on the context")
+	}
+	if rr.Header().Get(RequestIDHeader) != seen {
+		t.Fatalf("expected the response header to echo the generated id %q, got %q", seen, rr.Header().Get(RequestIDHeader))
+	}
+}
+
+func TestRequestIDPropagatesInboundHeader(t *testing.T) {
+	var seen string
+	h := RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(RequestIDHeader, "inbound-id")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	if seen != "inbound-id" {
+		t.Fatalf("expected the inbound id to be propagated, got %q", seen)
+	}
+	if rr.Header().Get(RequestIDHeader) != "inbound-id" {
+		t.Fatalf("expected the response to echo the inbound id, got %q", rr.Header().Get(RequestIDHeader))
+	}
+}
@@ -0,0 +1,9 @@
+package server
+
+import "github.com/go-chi/chi/v5"
+
+// NewChiHandler returns a chi.Router suitable for use as the handler passed
+// to NewHttpServer.
+func NewChiHandler() chi.Router {
+	return chi.NewRouter()
+}
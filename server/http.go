@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -19,6 +20,8 @@ type Options struct {
 	CertificateFile string
 	KeyFile         string
 	ShutdownTimeout int
+	DrainTimeout    time.Duration
+	NotReadyHook    func()
 }
 
 func Hostname(h string) Option {
@@ -46,13 +49,39 @@ func ShutdownTimeout(t int) Option {
 	}
 }
 
+// DrainTimeout sets how long Stop waits, after running NotReadyHook, before
+// calling srv.Shutdown. This gives load balancers time to deregister the
+// instance before in-flight connections start being drained.
+func DrainTimeout(d time.Duration) Option {
+	return func(o *Options) {
+		o.DrainTimeout = d
+	}
+}
+
+// NotReady registers a hook run at the start of Stop, before the drain
+// sleep, so callers can flip a readiness flag (e.g. the admin subsystem's
+// registry) before connections stop being accepted.
+func NotReady(fn func()) Option {
+	return func(o *Options) {
+		o.NotReadyHook = fn
+	}
+}
+
+var errNotStarted = errors.New("server: Stop called before Start")
+
 type HttpServer struct {
 	mtx     sync.RWMutex
 	srv     *http.Server
 	handler http.Handler
 	address string
 	options Options
-	exit    chan chan error
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	started   bool
+	done      chan struct{}
+	err       error
+	startErr  error
 }
 
 func NewHttpServer(handler http.Handler, opts ...Option) *HttpServer {
@@ -77,7 +106,7 @@ func NewHttpServer(handler http.Handler, opts ...Option) *HttpServer {
 		address: fmt.Sprintf("%s:%d", options.Hostname, options.Port),
 		handler: handler,
 		options: options,
-		exit:    make(chan chan error),
+		done:    make(chan struct{}),
 	}
 }
 
@@ -87,58 +116,132 @@ func (hs *HttpServer) Address() string {
 	return hs.address
 }
 
-func (hs *HttpServer) Start() error {
-	var l net.Listener
-	var err error
-	l, err = net.Listen("tcp", hs.address)
-	if err != nil {
-		return err
-	}
+// Start binds the listener and begins serving in the background. If ctx is
+// non-nil, cancelling it drives the same drain-then-shutdown path as an
+// explicit Stop call, so callers don't need to wire up their own watcher
+// goroutine around Stop. Start is safe to call only once; subsequent calls
+// are no-ops.
+func (hs *HttpServer) Start(ctx context.Context) error {
+	hs.startOnce.Do(func() {
+		l, err := net.Listen("tcp", hs.address)
+		if err != nil {
+			hs.mtx.Lock()
+			hs.startErr = err
+			hs.mtx.Unlock()
+			return
+		}
 
-	hs.mtx.Lock()
-	hs.address = l.Addr().String()
-	hs.mtx.Unlock()
+		hs.mtx.Lock()
+		hs.address = l.Addr().String()
+		hs.started = true
+		hs.mtx.Unlock()
 
-	log.Info().Msgf("Starting server. Listening at %s", hs.String())
+		log.Info().Msgf("Starting server. Listening at %s", hs.String())
 
-	hs.srv = &http.Server{Handler: hs.handler}
+		hs.srv = &http.Server{Handler: hs.handler}
 
-	go func() {
-		if hs.options.CertificateFile != "" && hs.options.KeyFile != "" {
-			if err := hs.srv.ServeTLS(l, hs.options.CertificateFile, hs.options.KeyFile); err != nil && err != http.ErrServerClosed {
-				log.Error().Msgf("%v", err)
+		go func() {
+			var serveErr error
+			if hs.options.CertificateFile != "" && hs.options.KeyFile != "" {
+				serveErr = hs.srv.ServeTLS(l, hs.options.CertificateFile, hs.options.KeyFile)
+			} else {
+				serveErr = hs.srv.Serve(l)
+			}
+			if serveErr != nil && serveErr != http.ErrServerClosed {
+				log.Error().Msgf("%v", serveErr)
+				hs.setErr(serveErr)
 			}
-		} else if err := hs.srv.Serve(l); err != nil && err != http.ErrServerClosed {
-			log.Error().Msgf("%v", err)
+			hs.stop()
+		}()
+
+		if ctx != nil {
+			go func() {
+				select {
+				case <-ctx.Done():
+					hs.stop()
+				case <-hs.done:
+				}
+			}()
 		}
-	}()
+	})
+
+	hs.mtx.RLock()
+	defer hs.mtx.RUnlock()
+	return hs.startErr
+}
 
-	go func() {
-		ch := <-hs.exit
+// Stop drains and shuts down the server: it runs the NotReadyHook, waits
+// DrainTimeout, then calls srv.Shutdown with ShutdownTimeout, falling back
+// to srv.Close if shutdown doesn't complete in time. It blocks until the
+// server has fully stopped and is safe to call more than once, or
+// concurrently with a ctx cancellation passed to Start - only the first
+// caller performs the shutdown, the rest just wait on it.
+func (hs *HttpServer) Stop() error {
+	hs.mtx.RLock()
+	started := hs.started
+	hs.mtx.RUnlock()
+	if !started {
+		return errNotStarted
+	}
+
+	log.Info().Msg("Stopping server")
+	hs.stop()
+	<-hs.done
+
+	if err := hs.Err(); err != nil {
+		return err
+	}
+	log.Info().Msg("Stopped server.")
+	return nil
+}
+
+func (hs *HttpServer) stop() {
+	hs.stopOnce.Do(func() {
+		if hs.options.NotReadyHook != nil {
+			hs.options.NotReadyHook()
+		}
+
+		if hs.options.DrainTimeout > 0 {
+			time.Sleep(hs.options.DrainTimeout)
+		}
 
 		ctxShutDown, cancel := context.WithTimeout(context.Background(), time.Duration(hs.options.ShutdownTimeout)*time.Second)
-		defer func() {
-			cancel()
-		}()
-		if err = hs.srv.Shutdown(ctxShutDown); err != nil {
+		defer cancel()
+
+		if err := hs.srv.Shutdown(ctxShutDown); err != nil {
 			log.Error().Msgf("Server Shutdown Failed:%+s", err)
+			hs.setErr(err)
+			if err == context.DeadlineExceeded {
+				if closeErr := hs.srv.Close(); closeErr != nil {
+					log.Error().Msgf("Server Close Failed:%+s", closeErr)
+				}
+			}
 		}
 
-		ch <- nil
-	}()
-
-	return nil
+		close(hs.done)
+	})
 }
 
-func (hs *HttpServer) Stop() error {
-	log.Info().Msg("Stopping server")
-	ch := make(chan error)
-	hs.exit <- ch
-	var err error = <-ch
-	if err == nil {
-		log.Info().Msg("Stopped server.")
+func (hs *HttpServer) setErr(err error) {
+	hs.mtx.Lock()
+	defer hs.mtx.Unlock()
+	if hs.err == nil {
+		hs.err = err
 	}
-	return err
+}
+
+// Done returns a channel that is closed once the server has fully stopped,
+// whether from Stop, ctx cancellation, or Serve failing on its own.
+func (hs *HttpServer) Done() <-chan struct{} {
+	return hs.done
+}
+
+// Err returns the first error observed from Serve/ServeTLS or from
+// Shutdown/Close, or nil if the server stopped cleanly.
+func (hs *HttpServer) Err() error {
+	hs.mtx.RLock()
+	defer hs.mtx.RUnlock()
+	return hs.err
 }
 
 func (s *HttpServer) String() string {
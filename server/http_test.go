@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"io/ioutil"
@@ -8,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 type stubHandler struct {
@@ -30,7 +32,7 @@ func NewStubHandler(message string, status int) *stubHandler {
 func TestHttpServer(t *testing.T) {
 	s := NewHttpServer(NewStubHandler("Hello World!", 200), Hostname("localhost"), Port(-1))
 
-	if err := s.Start(); err != nil {
+	if err := s.Start(context.Background()); err != nil {
 		t.Fatal(err)
 	}
 
@@ -73,7 +75,7 @@ func TestHttpsServer(t *testing.T) {
 			fmt.Sprintf("%s/cert/cert.pem", certPath),
 			fmt.Sprintf("%s/cert/key.pem", certPath)))
 
-	if err := s.Start(); err != nil {
+	if err := s.Start(context.Background()); err != nil {
 		t.Fatal(err)
 	}
 
@@ -108,6 +110,48 @@ func TestHttpsServer(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestHttpServerStopBeforeStart(t *testing.T) {
+	s := NewHttpServer(NewStubHandler("Hello World!", 200), Hostname("localhost"), Port(-1))
+
+	if err := s.Stop(); err == nil {
+		t.Fatal("expected an error stopping a server that was never started")
+	}
+}
+
+func TestHttpServerStopTwice(t *testing.T) {
+	s := NewHttpServer(NewStubHandler("Hello World!", 200), Hostname("localhost"), Port(-1))
+
+	if err := s.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHttpServerContextCancel(t *testing.T) {
+	s := NewHttpServer(NewStubHandler("Hello World!", 200), Hostname("localhost"), Port(-1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := s.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	cancel()
+
+	select {
+	case <-s.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not stop after context cancellation")
+	}
+}
+
 func TestChiHttpServer(t *testing.T) {
 	h := NewChiHandler()
 	h.Method(http.MethodGet, "/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -117,7 +161,7 @@ func TestChiHttpServer(t *testing.T) {
 
 	s := NewHttpServer(h)
 
-	if err := s.Start(); err != nil {
+	if err := s.Start(context.Background()); err != nil {
 		t.Fatal(err)
 	}
 
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// AdminOption configures the optional admin listener set up via Admin.
+type AdminOption func(o *AdminOptions)
+
+// AdminOptions holds the admin listener configuration.
+type AdminOptions struct {
+	Enabled         bool
+	Port            int
+	BasicAuthUser   string
+	BasicAuthPass   string
+	CertificateFile string
+	KeyFile         string
+	DrainPeriod     time.Duration
+}
+
+// AdminBasicAuth protects the admin listener with HTTP basic auth.
+func AdminBasicAuth(user, pass string) AdminOption {
+	return func(o *AdminOptions) {
+		o.BasicAuthUser = user
+		o.BasicAuthPass = pass
+	}
+}
+
+// AdminTLS serves the admin listener over TLS using the given certificate
+// and key files.
+func AdminTLS(certFile, keyFile string) AdminOption {
+	return func(o *AdminOptions) {
+		o.CertificateFile = certFile
+		o.KeyFile = keyFile
+	}
+}
+
+// AdminDrainPeriod sets how long Stop waits, after marking the service
+// not-ready and running the BeforeStop hooks, before the public listener is
+// shut down - giving load balancers time to deregister the instance.
+func AdminDrainPeriod(d time.Duration) AdminOption {
+	return func(o *AdminOptions) {
+		o.DrainPeriod = d
+	}
+}
+
+// Admin starts a second HTTP listener, separate from the public one, that
+// exposes /healthz, /readyz, /metrics (only when Metrics is also set),
+// /debug/pprof/* and /debug/vars so operator endpoints don't share the
+// public port or appear in application metrics.
+func Admin(port int, opts ...AdminOption) Option {
+	return func(o *Options) {
+		o.Admin.Enabled = true
+		o.Admin.Port = port
+		for _, fn := range opts {
+			fn(&o.Admin)
+		}
+	}
+}
+
+// HealthCheck registers a named probe whose result is reported by /healthz
+// for diagnostics; it does not affect /healthz's status code.
+func HealthCheck(name string, check func(ctx context.Context) error) Option {
+	return func(o *Options) {
+		o.HealthChecks = append(o.HealthChecks, namedCheck{Name: name, Fn: check})
+	}
+}
+
+// ReadinessCheck registers a named probe that gates /readyz: if it fails,
+// /readyz responds 503.
+func ReadinessCheck(name string, check func(ctx context.Context) error) Option {
+	return func(o *Options) {
+		o.ReadinessChecks = append(o.ReadinessChecks, namedCheck{Name: name, Fn: check})
+	}
+}
+
+type namedCheck struct {
+	Name string
+	Fn   func(ctx context.Context) error
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestServiceAdminListener(t *testing.T) {
+	s, err := NewService("test-admin", "v0.1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Init(
+		ReadinessCheck("dep", func(ctx context.Context) error { return errors.New("dep unavailable") }),
+		Admin(-1)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := s.Stop(); err != nil {
+			t.Fatalf("Unexpected error stopping service: %v", err)
+		}
+	}()
+
+	adminAddr := s.adminServer.Address()
+
+	rsp, err := http.Get(fmt.Sprintf("http://%s/healthz", adminAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		t.Fatalf("expected /healthz to be 200, got %d", rsp.StatusCode)
+	}
+
+	rsp, err = http.Get(fmt.Sprintf("http://%s/readyz", adminAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsp.Body.Close()
+	if rsp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected /readyz to be 503 with a failing readiness check, got %d", rsp.StatusCode)
+	}
+}
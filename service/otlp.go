@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/credentials"
+)
+
+type OTLPOption func(o *otlpOptions)
+
+type otlpOptions struct {
+	Endpoint        string
+	Path            string
+	TLSConfig       *tls.Config
+	Insecure        bool
+	ProxyURL        string
+	Compress        bool
+	Headers         map[string]string
+	Timeout         time.Duration
+	RetryInitial    time.Duration
+	RetryMax        time.Duration
+	RetryMaxElapsed time.Duration
+}
+
+// OTLPEndpoint sets the collector host:port the exporter connects to.
+func OTLPEndpoint(endpoint string) OTLPOption {
+	return func(o *otlpOptions) {
+		o.Endpoint = endpoint
+	}
+}
+
+// OTLPPath sets the URL path the HTTP exporter posts spans to. It has no
+// effect on OTLPGRPC. Defaults to "/v1/traces".
+func OTLPPath(path string) OTLPOption {
+	return func(o *otlpOptions) {
+		o.Path = path
+	}
+}
+
+// OTLPTLSConfig sets the TLS config used to dial the collector.
+func OTLPTLSConfig(cfg *tls.Config) OTLPOption {
+	return func(o *otlpOptions) {
+		o.TLSConfig = cfg
+	}
+}
+
+// OTLPInsecure disables transport security when dialing the collector.
+func OTLPInsecure() OTLPOption {
+	return func(o *otlpOptions) {
+		o.Insecure = true
+	}
+}
+
+// OTLPProxy routes exporter traffic through an HTTP proxy. The underlying
+// client resolves proxies from the environment (HTTP_PROXY/HTTPS_PROXY/
+// NO_PROXY), so this sets HTTP_PROXY and HTTPS_PROXY for the current
+// process; NO_PROXY is left as it is.
+func OTLPProxy(proxyURL string) OTLPOption {
+	return func(o *otlpOptions) {
+		o.ProxyURL = proxyURL
+	}
+}
+
+// OTLPCompression gzip-compresses the exported spans.
+func OTLPCompression() OTLPOption {
+	return func(o *otlpOptions) {
+		o.Compress = true
+	}
+}
+
+// OTLPHeaders attaches static headers to every export request, e.g. for
+// vendor auth tokens.
+func OTLPHeaders(headers map[string]string) OTLPOption {
+	return func(o *otlpOptions) {
+		o.Headers = headers
+	}
+}
+
+// OTLPTimeout bounds how long a single export request may take.
+func OTLPTimeout(d time.Duration) OTLPOption {
+	return func(o *otlpOptions) {
+		o.Timeout = d
+	}
+}
+
+// OTLPRetry configures the exponential backoff used to retry failed export
+// requests (429/503 are retried, honoring Retry-After when present).
+func OTLPRetry(initialInterval, maxInterval, maxElapsedTime time.Duration) OTLPOption {
+	return func(o *otlpOptions) {
+		o.RetryInitial = initialInterval
+		o.RetryMax = maxInterval
+		o.RetryMaxElapsed = maxElapsedTime
+	}
+}
+
+func newOTLPOptions(opts ...OTLPOption) otlpOptions {
+	var o otlpOptions
+	for _, fn := range opts {
+		fn(&o)
+	}
+	return o
+}
+
+func (o otlpOptions) applyProxy() {
+	if o.ProxyURL == "" {
+		return
+	}
+	os.Setenv("HTTPS_PROXY", o.ProxyURL)
+	os.Setenv("HTTP_PROXY", o.ProxyURL)
+}
+
+// OTLPHTTP returns a SpanExporter that ships spans to a collector
+// (Jaeger, Tempo, Honeycomb, etc.) over OTLP/HTTP.
+func OTLPHTTP(opts ...OTLPOption) (sdktrace.SpanExporter, error) {
+	o := newOTLPOptions(opts...)
+	o.applyProxy()
+
+	httpOpts := []otlptracehttp.Option{}
+	if o.Endpoint != "" {
+		httpOpts = append(httpOpts, otlptracehttp.WithEndpoint(o.Endpoint))
+	}
+	if o.Path != "" {
+		httpOpts = append(httpOpts, otlptracehttp.WithURLPath(o.Path))
+	}
+	if o.TLSConfig != nil {
+		httpOpts = append(httpOpts, otlptracehttp.WithTLSClientConfig(o.TLSConfig))
+	}
+	if o.Insecure {
+		httpOpts = append(httpOpts, otlptracehttp.WithInsecure())
+	}
+	if o.Compress {
+		httpOpts = append(httpOpts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+	}
+	if len(o.Headers) > 0 {
+		httpOpts = append(httpOpts, otlptracehttp.WithHeaders(o.Headers))
+	}
+	if o.Timeout > 0 {
+		httpOpts = append(httpOpts, otlptracehttp.WithTimeout(o.Timeout))
+	}
+	if o.RetryInitial > 0 || o.RetryMax > 0 || o.RetryMaxElapsed > 0 {
+		httpOpts = append(httpOpts, otlptracehttp.WithRetry(otlptracehttp.RetryConfig{
+			Enabled:         true,
+			InitialInterval: o.RetryInitial,
+			MaxInterval:     o.RetryMax,
+			MaxElapsedTime:  o.RetryMaxElapsed,
+		}))
+	}
+
+	return otlptracehttp.New(context.Background(), httpOpts...)
+}
+
+// OTLPGRPC returns a SpanExporter that ships spans to a collector
+// (Jaeger, Tempo, Honeycomb, etc.) over OTLP/gRPC.
+func OTLPGRPC(opts ...OTLPOption) (sdktrace.SpanExporter, error) {
+	o := newOTLPOptions(opts...)
+	o.applyProxy()
+
+	grpcOpts := []otlptracegrpc.Option{}
+	if o.Endpoint != "" {
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithEndpoint(o.Endpoint))
+	}
+	if o.TLSConfig != nil {
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(o.TLSConfig)))
+	}
+	if o.Insecure {
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithInsecure())
+	}
+	if o.Compress {
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithCompressor("gzip"))
+	}
+	if len(o.Headers) > 0 {
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithHeaders(o.Headers))
+	}
+	if o.Timeout > 0 {
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithTimeout(o.Timeout))
+	}
+	if o.RetryInitial > 0 || o.RetryMax > 0 || o.RetryMaxElapsed > 0 {
+		grpcOpts = append(grpcOpts, otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{
+			Enabled:         true,
+			InitialInterval: o.RetryInitial,
+			MaxInterval:     o.RetryMax,
+			MaxElapsedTime:  o.RetryMaxElapsed,
+		}))
+	}
+
+	return otlptracegrpc.New(context.Background(), grpcOpts...)
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOTLPHTTP(t *testing.T) {
+	exp, err := OTLPHTTP(
+		OTLPEndpoint("localhost:4318"),
+		OTLPPath("/v1/traces"),
+		OTLPInsecure(),
+		OTLPCompression(),
+		OTLPHeaders(map[string]string{"x-api-key": "test"}),
+		OTLPTimeout(5*time.Second),
+		OTLPRetry(time.Second, 5*time.Second, time.Minute),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp == nil {
+		t.Fatal("expected a non-nil exporter")
+	}
+}
+
+func TestOTLPGRPC(t *testing.T) {
+	exp, err := OTLPGRPC(
+		OTLPEndpoint("localhost:4317"),
+		OTLPInsecure(),
+		OTLPCompression(),
+		OTLPHeaders(map[string]string{"x-api-key": "test"}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp == nil {
+		t.Fatal("expected a non-nil exporter")
+	}
+}
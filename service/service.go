@@ -5,14 +5,23 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
 
-	"github.com/go-chi/chi/v5"
+	"github.com/codesage77/micro/admin"
+	"github.com/codesage77/micro/metrics"
 	"github.com/codesage77/micro/server"
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog/log"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
+// traceShutdownTimeout bounds how long Stop waits for in-flight spans to be
+// flushed and exported during shutdown.
+const traceShutdownTimeout = 5 * time.Second
+
 type Option func(o *Options)
 
 type Options struct {
@@ -28,6 +37,16 @@ type Options struct {
 	Signal          bool
 	Sampler         sdktrace.Sampler
 	Exporter        sdktrace.SpanExporter
+	ResourceAttrs   map[string]string
+
+	MetricsRegisterer prometheus.Registerer
+	MetricsPath       string
+
+	Middlewares []Middleware
+
+	Admin           AdminOptions
+	HealthChecks    []namedCheck
+	ReadinessChecks []namedCheck
 }
 
 func BeforeStart(fn func() error) Option {
@@ -80,18 +99,62 @@ func Tracing(s sdktrace.Sampler, e sdktrace.SpanExporter) Option {
 	}
 }
 
+// ResourceAttributes adds extra key/value pairs to the OpenTelemetry resource
+// alongside the standard service.name/service.version attributes.
+func ResourceAttributes(attrs map[string]string) Option {
+	return func(o *Options) {
+		o.ResourceAttrs = attrs
+	}
+}
+
+// Metrics enables Prometheus instrumentation of every registered endpoint,
+// registering the collectors against reg.
+func Metrics(reg prometheus.Registerer) Option {
+	return func(o *Options) {
+		o.MetricsRegisterer = reg
+	}
+}
+
+// MetricsEndpoint mounts the Prometheus scrape handler at path on the
+// service's chi router. Requires Metrics to also be set.
+func MetricsEndpoint(path string) Option {
+	return func(o *Options) {
+		o.MetricsPath = path
+	}
+}
+
+// Middleware wraps an http.Handler to run code before and/or after it,
+// short-circuit the chain, wrap the ResponseWriter, or thread values
+// through the request context.
+type Middleware func(http.Handler) http.Handler
+
+// Use registers service-wide middleware, run for every endpoint outside of
+// any per-endpoint middleware and inside the tracing wrapper.
+func Use(mw ...Middleware) Option {
+	return func(o *Options) {
+		o.Middlewares = append(o.Middlewares, mw...)
+	}
+}
+
 type Endpoint struct {
 	Name        string
 	Method      string
 	URI         string
 	HandlerFunc http.HandlerFunc
-	Decorators  []EndpointDecorator
+	Middlewares []Middleware
+
+	// Deprecated: use Middlewares instead. Decorators can't short-circuit
+	// the chain, wrap the ResponseWriter, observe status codes, or thread
+	// values through context.Context.
+	Decorators []EndpointDecorator
 }
 
+// Deprecated: use Middleware instead.
 func BeforeDecorator(df func(w http.ResponseWriter, r *http.Request)) EndpointDecorator {
 	return EndpointDecorator{Type: Before, DecoratorFunc: df}
 }
 
+// Deprecated: use Middleware instead.
 func AfterDecorator(df func(w http.ResponseWriter, r *http.Request)) EndpointDecorator {
 	return EndpointDecorator{Type: After, DecoratorFunc: df}
 }
@@ -103,6 +166,7 @@ const (
 	After
 )
 
+// Deprecated: use Middleware instead.
 type EndpointDecorator struct {
 	Type          EndpointDecoratorType
 	DecoratorFunc func(w http.ResponseWriter, r *http.Request)
@@ -115,6 +179,13 @@ type Service struct {
 	server        *server.HttpServer
 	handler       chi.Router
 	traceProvider *sdktrace.TracerProvider
+	metrics       *metrics.Metrics
+	adminServer   *server.HttpServer
+	registry      *admin.Registry
+	wsCtx         context.Context
+	wsCancel      context.CancelFunc
+	stopOnce      sync.Once
+	stopErr       error
 }
 
 func NewService(name string, version string) (*Service, error) {
@@ -136,6 +207,40 @@ func (s *Service) Init(opts ...Option) error {
 		options.Context = context.Background()
 	}
 	s.opts = options
+	s.wsCtx, s.wsCancel = context.WithCancel(options.Context)
+
+	if options.MetricsRegisterer != nil {
+		s.metrics = metrics.New(options.MetricsRegisterer)
+		s.opts.Middlewares = append(s.opts.Middlewares, Middleware(s.metrics.Wrap))
+		if options.MetricsPath != "" {
+			s.handler.Method(http.MethodGet, options.MetricsPath, s.metrics.Handler())
+		}
+	}
+
+	if options.Admin.Enabled {
+		s.registry = admin.NewRegistry()
+		for _, c := range options.HealthChecks {
+			s.registry.AddHealthCheck(c.Name, c.Fn)
+		}
+		for _, c := range options.ReadinessChecks {
+			s.registry.AddReadinessCheck(c.Name, c.Fn)
+		}
+
+		var metricsHandler http.Handler
+		if s.metrics != nil {
+			metricsHandler = s.metrics.Handler()
+		}
+
+		mux := admin.NewMux(s.registry, admin.MuxOptions{
+			BasicAuthUser:  options.Admin.BasicAuthUser,
+			BasicAuthPass:  options.Admin.BasicAuthPass,
+			MetricsHandler: metricsHandler,
+		})
+		s.adminServer = server.NewHttpServer(mux,
+			server.Port(options.Admin.Port),
+			server.TLS(options.Admin.CertificateFile, options.Admin.KeyFile))
+	}
+
 	return nil
 }
 
@@ -146,8 +251,14 @@ func (s *Service) Endpoints(endpoints ...Endpoint) error {
 			h = decorateHandler(h, d)
 		}
 
+		// Compose outer -> inner: tracing wrapper, service middleware,
+		// per-endpoint middleware, handler - tracing wraps everything else
+		// so middleware runs with the traced context already in place.
+		h = chainMiddleware(h, ep.Middlewares)
+		h = chainMiddleware(h, s.opts.Middlewares)
+
 		if s.opts.Sampler != nil && s.opts.Exporter != nil {
-			tp, err := initTracer(s.name, s.version, s.opts.Sampler, s.opts.Exporter)
+			tp, err := initTracer(s.name, s.version, s.opts.Sampler, s.opts.Exporter, s.opts.ResourceAttrs)
 			if err != nil {
 				return err
 			}
@@ -160,6 +271,13 @@ func (s *Service) Endpoints(endpoints ...Endpoint) error {
 	return nil
 }
 
+func chainMiddleware(h http.Handler, mws []Middleware) http.Handler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
+
 func (s *Service) Name() string {
 	return s.name
 }
@@ -173,14 +291,32 @@ func (s *Service) Server() *server.HttpServer {
 }
 
 func (s *Service) Start() error {
+	if s.registry != nil {
+		s.registry.SetReady(false)
+	}
+
 	for _, fn := range s.opts.BeforeStart {
 		if err := fn(); err != nil {
 			return err
 		}
 	}
 
-	s.server = server.NewHttpServer(s.handler, server.Port(s.opts.Port), server.TLS(s.opts.CertificateFile, s.opts.KeyFile))
-	if err := s.server.Start(); err != nil {
+	if s.adminServer != nil {
+		if err := s.adminServer.Start(s.opts.Context); err != nil {
+			return err
+		}
+	}
+
+	serverOpts := []server.Option{server.Port(s.opts.Port), server.TLS(s.opts.CertificateFile, s.opts.KeyFile)}
+	if s.opts.Admin.DrainPeriod > 0 {
+		serverOpts = append(serverOpts, server.DrainTimeout(s.opts.Admin.DrainPeriod))
+	}
+	if s.registry != nil {
+		serverOpts = append(serverOpts, server.NotReady(func() { s.registry.SetReady(false) }))
+	}
+
+	s.server = server.NewHttpServer(s.handler, serverOpts...)
+	if err := s.server.Start(s.opts.Context); err != nil {
 		return err
 	}
 
@@ -190,10 +326,14 @@ func (s *Service) Start() error {
 		}
 	}
 
+	if s.registry != nil {
+		s.registry.SetReady(true)
+	}
+
 	ch := make(chan os.Signal, 1)
 	if s.opts.Signal {
 		signals := []os.Signal{
-			syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGKILL,
+			syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT,
 		}
 		signal.Notify(ch, signals...)
 	}
@@ -204,6 +344,12 @@ func (s *Service) Start() error {
 		case <-ch:
 		// wait on context cancel
 		case <-s.opts.Context.Done():
+		// the public listener stopped on its own, e.g. a bind/serve
+		// failure surfaced after Start returned
+		case <-s.server.Done():
+			if err := s.server.Err(); err != nil {
+				log.Error().Msgf("Server stopped unexpectedly: %v", err)
+			}
 		}
 
 		if err := s.Stop(); err != nil {
@@ -214,15 +360,47 @@ func (s *Service) Start() error {
 	return nil
 }
 
+// Stop is safe to call more than once - e.g. once explicitly by the caller
+// and once more from the watcher goroutine started by Start when the
+// public listener's Done channel fires as a result of that same Stop call.
+// Only the first call runs the shutdown sequence; later calls just return
+// its result.
 func (s *Service) Stop() error {
+	s.stopOnce.Do(func() {
+		s.stopErr = s.stop()
+	})
+	return s.stopErr
+}
+
+func (s *Service) stop() error {
+	if s.registry != nil {
+		s.registry.SetReady(false)
+	}
+
+	if s.wsCancel != nil {
+		s.wsCancel()
+	}
+
 	for _, fn := range s.opts.BeforeStop {
 		if err := fn(); err != nil {
 			return err
 		}
 	}
 
-	if err := s.Server().Stop(); err != nil {
-		return err
+	// Stop the admin listener even if the public server was never
+	// successfully started (e.g. it failed to bind) - otherwise a bind
+	// failure during Start leaves the admin listener's goroutine and
+	// socket running with no way for the caller to reach it.
+	serverErr := s.Server().Stop()
+
+	if s.adminServer != nil {
+		if err := s.adminServer.Stop(); err != nil {
+			log.Info().Msgf("An error occurred when stopping the admin listener %v", err)
+		}
+	}
+
+	if serverErr != nil {
+		return serverErr
 	}
 
 	for _, fn := range s.opts.AfterStop {
@@ -232,7 +410,17 @@ func (s *Service) Stop() error {
 	}
 
 	if s.traceProvider != nil {
-		return s.traceProvider.Shutdown(s.opts.Context)
+		// Use a fresh context rather than s.opts.Context: on the ctx-cancel
+		// shutdown path that context is already Done, and ForceFlush/Shutdown
+		// both bail out immediately on an already-canceled context, dropping
+		// in-flight spans instead of flushing them.
+		ctxShutDown, cancel := context.WithTimeout(context.Background(), traceShutdownTimeout)
+		defer cancel()
+
+		if err := s.traceProvider.ForceFlush(ctxShutDown); err != nil {
+			log.Info().Msgf("An error occurred when flushing spans %v", err)
+		}
+		return s.traceProvider.Shutdown(ctxShutDown)
 	}
 	return nil
 }
@@ -10,11 +10,16 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
 )
 
-func initTracer(name string, version string, sampler sdktrace.Sampler, exporter sdktrace.SpanExporter) (*sdktrace.TracerProvider, error) {
-	resource := resource.NewWithAttributes(
-		semconv.SchemaURL,
-		semconv.ServiceNameKey.String(name))
-	semconv.ServiceVersionKey.String(version)
+func initTracer(name string, version string, sampler sdktrace.Sampler, exporter sdktrace.SpanExporter, resourceAttrs map[string]string) (*sdktrace.TracerProvider, error) {
+	attrs := []attribute.KeyValue{
+		semconv.ServiceNameKey.String(name),
+		semconv.ServiceVersionKey.String(version),
+	}
+	for k, v := range resourceAttrs {
+		attrs = append(attrs, attribute.String(k, v))
+	}
+
+	resource := resource.NewWithAttributes(semconv.SchemaURL, attrs...)
 
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithSampler(sampler),
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/codesage77/micro/ws"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// WebSocketEndpoint registers a streaming endpoint for interactive/bidi
+// workloads (terminals, notifications, chat).
+type WebSocketEndpoint struct {
+	Name         string
+	URI          string
+	Handler      func(conn ws.Conn, r *http.Request) error
+	Subprotocols []string
+	ReadLimit    int64
+	PingInterval time.Duration
+	PongTimeout  time.Duration
+
+	// OriginChecker validates the request Origin header during the
+	// upgrade handshake. If nil, gorilla's default same-origin check
+	// applies: a request with an Origin header is rejected unless that
+	// header's host matches the request Host; a request without an Origin
+	// header is accepted.
+	OriginChecker func(r *http.Request) bool
+
+	// OnDisconnect, if set, is called once the connection closes, with the
+	// error Handler returned (nil on a clean close).
+	OnDisconnect func(r *http.Request, err error)
+}
+
+// WebSockets registers streaming WebSocket endpoints on the service's
+// router. The context passed to each Handler is canceled when Service.Stop
+// runs, so long-lived connections are terminated instead of blocking
+// shutdown until the server's shutdown timeout elapses.
+func (s *Service) WebSockets(endpoints ...WebSocketEndpoint) error {
+	for _, ep := range endpoints {
+		ep := ep
+		s.handler.Method(http.MethodGet, ep.URI, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			s.serveWebSocket(ep, w, r)
+		}))
+	}
+	return nil
+}
+
+func (s *Service) serveWebSocket(ep WebSocketEndpoint, w http.ResponseWriter, r *http.Request) {
+	conn, err := (ws.Upgrader{Subprotocols: ep.Subprotocols, OriginChecker: ep.OriginChecker}).Upgrade(w, r)
+	if err != nil {
+		log.Error().Msgf("websocket upgrade failed for %s: %v", ep.Name, err)
+		return
+	}
+	defer conn.Close()
+
+	if ep.ReadLimit > 0 {
+		conn.SetReadLimit(ep.ReadLimit)
+	}
+
+	ctx := s.wsCtx
+	var span oteltrace.Span
+	if s.traceProvider != nil {
+		ctx, span = s.traceProvider.Tracer(s.name).Start(ctx, ep.Name)
+		defer span.End()
+		span.SetAttributes(
+			attribute.String("http.target", r.RequestURI),
+			attribute.String("http.host", r.Host),
+		)
+		conn = tracedConn{Conn: conn, span: span}
+	}
+
+	if ep.PongTimeout > 0 {
+		conn.SetReadDeadline(time.Now().Add(ep.PongTimeout))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(ep.PongTimeout))
+		})
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	if ep.PingInterval > 0 {
+		go pingLoop(conn, ep.PingInterval, ctx, done)
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	err = ep.Handler(conn, r.WithContext(ctx))
+	if span != nil && err != nil {
+		span.RecordError(err)
+	}
+	if ep.OnDisconnect != nil {
+		ep.OnDisconnect(r, err)
+	}
+}
+
+// tracedConn wraps a ws.Conn to emit a span event for every message sent or
+// received, recording its direction and size.
+type tracedConn struct {
+	ws.Conn
+	span oteltrace.Span
+}
+
+func (c tracedConn) ReadMessage() (int, []byte, error) {
+	messageType, p, err := c.Conn.ReadMessage()
+	if err == nil {
+		c.span.AddEvent("ws.message", oteltrace.WithAttributes(
+			attribute.String("ws.direction", "received"),
+			attribute.Int("ws.size", len(p)),
+		))
+	}
+	return messageType, p, err
+}
+
+func (c tracedConn) WriteMessage(messageType int, data []byte) error {
+	err := c.Conn.WriteMessage(messageType, data)
+	if err == nil {
+		c.span.AddEvent("ws.message", oteltrace.WithAttributes(
+			attribute.String("ws.direction", "sent"),
+			attribute.Int("ws.size", len(data)),
+		))
+	}
+	return err
+}
+
+func pingLoop(conn ws.Conn, interval time.Duration, ctx context.Context, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := conn.WriteMessage(ws.PingMessage, nil); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codesage77/micro/ws"
+	"github.com/gorilla/websocket"
+)
+
+func TestServiceWebSocketsEcho(t *testing.T) {
+	s, err := NewService("test-ws", "v0.1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan string, 1)
+	err = s.WebSockets(WebSocketEndpoint{
+		Name: "echo",
+		URI:  "/ws",
+		Handler: func(conn ws.Conn, r *http.Request) error {
+			_, p, err := conn.ReadMessage()
+			if err != nil {
+				return err
+			}
+			received <- string(p)
+			return conn.WriteMessage(ws.TextMessage, p)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(s.handler)
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := conn.WriteMessage(websocket.TextMessage, []byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case got := <-received:
+		if got != "hello" {
+			t.Fatalf("unexpected message seen by Handler, got %q", got)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for Handler to receive the message")
+	}
+
+	_, reply, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(reply) != "hello" {
+		t.Fatalf("unexpected echo, got %q", string(reply))
+	}
+}
+
+func TestServiceWebSocketsOnDisconnect(t *testing.T) {
+	s, err := NewService("test-ws-disconnect", "v0.1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	disconnected := make(chan struct{})
+	err = s.WebSockets(WebSocketEndpoint{
+		Name: "noop",
+		URI:  "/ws",
+		Handler: func(conn ws.Conn, r *http.Request) error {
+			_, _, err := conn.ReadMessage()
+			return err
+		},
+		OnDisconnect: func(r *http.Request, err error) {
+			close(disconnected)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(s.handler)
+	defer srv.Close()
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+
+	select {
+	case <-disconnected:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for OnDisconnect to fire")
+	}
+}
@@ -0,0 +1,73 @@
+// Package ws upgrades HTTP requests to WebSocket connections for streaming
+// endpoints, behind an interface so the underlying client library is
+// swappable.
+package ws
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Conn is the subset of a WebSocket connection this package depends on. It
+// is satisfied by *websocket.Conn from github.com/gorilla/websocket.
+type Conn interface {
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	SetReadLimit(limit int64)
+	SetReadDeadline(t time.Time) error
+	SetWriteDeadline(t time.Time) error
+	SetPongHandler(h func(appData string) error)
+	Close() error
+}
+
+// Message type constants, mirroring github.com/gorilla/websocket.
+const (
+	TextMessage   = websocket.TextMessage
+	BinaryMessage = websocket.BinaryMessage
+	CloseMessage  = websocket.CloseMessage
+	PingMessage   = websocket.PingMessage
+	PongMessage   = websocket.PongMessage
+)
+
+// Upgrader upgrades incoming HTTP requests to WebSocket connections.
+type Upgrader struct {
+	Subprotocols []string
+
+	// OriginChecker validates the request Origin header. If nil, gorilla's
+	// default same-origin check applies: a request with an Origin header is
+	// rejected unless that header's host matches the request Host; a request
+	// without an Origin header is accepted.
+	OriginChecker func(r *http.Request) bool
+}
+
+// Upgrade completes the WebSocket handshake and returns the resulting
+// connection. The returned Conn serializes WriteMessage calls, since the
+// underlying gorilla connection panics if written to concurrently from more
+// than one goroutine (e.g. a keepalive ping racing the caller's handler).
+func (u Upgrader) Upgrade(w http.ResponseWriter, r *http.Request) (Conn, error) {
+	gu := websocket.Upgrader{Subprotocols: u.Subprotocols}
+	if u.OriginChecker != nil {
+		gu.CheckOrigin = u.OriginChecker
+	}
+	conn, err := gu.Upgrade(w, r, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &syncConn{Conn: conn}, nil
+}
+
+// syncConn wraps a Conn so that WriteMessage calls from different
+// goroutines don't race on the underlying connection.
+type syncConn struct {
+	Conn
+	mu sync.Mutex
+}
+
+func (c *syncConn) WriteMessage(messageType int, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.Conn.WriteMessage(messageType, data)
+}